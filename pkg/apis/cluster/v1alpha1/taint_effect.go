@@ -0,0 +1,27 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import corev1 "k8s.io/api/core/v1"
+
+// TaintEffectNoScheduleNoAdmit is a Karmada-specific taint effect, filling the gap between the
+// upstream NoSchedule (blocks new scheduling only) and NoExecute (evicts immediately, subject to
+// tolerationSeconds) effects: it blocks new bindings from being scheduled to the cluster *and*
+// forces every already-scheduled binding to be re-evaluated on the scheduler's next cycle, but,
+// unlike NoExecute, it never honors tolerationSeconds - a binding either currently tolerates it
+// or it doesn't.
+const TaintEffectNoScheduleNoAdmit corev1.TaintEffect = "NoScheduleNoAdmit"
@@ -0,0 +1,147 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+)
+
+// ClusterTaintManagerName is the controller name that will be used when reporting events.
+const ClusterTaintManagerName = "cluster-resource-binding-taint-manager"
+
+// ClusterTaintManager is the ClusterResourceBinding counterpart of TaintManager, evicting
+// cluster-scoped bindings that no longer tolerate a cluster's NoExecute taints.
+type ClusterTaintManager struct {
+	Client        client.Client
+	EventRecorder record.EventRecorder
+}
+
+var _ controllerruntime.Reconciler = &ClusterTaintManager{}
+
+// SetupWithManager creates a controller and registers it with the manager, watching Cluster
+// objects directly so a taint change is mapped straight to the ClusterResourceBindings currently
+// scheduled onto that cluster instead of waiting for the binding itself to change. The watch
+// reuses TaintManager's clusterTaintsChangedPredicate so a heartbeat-only Cluster update, which
+// leaves Spec.Taints untouched, doesn't trigger the List+map fan-out below.
+func (m *ClusterTaintManager) SetupWithManager(mgr controllerruntime.Manager) error {
+	return controllerruntime.NewControllerManagedBy(mgr).
+		Named(ClusterTaintManagerName).
+		For(&workv1alpha2.ClusterResourceBinding{}).
+		Watches(&clusterv1alpha1.Cluster{}, handler.EnqueueRequestsFromMapFunc(m.clusterTaintToBindingsMapFunc),
+			builder.WithPredicates(clusterTaintsChangedPredicate)).
+		Complete(m)
+}
+
+// clusterTaintToBindingsMapFunc maps a Cluster event to every ClusterResourceBinding currently
+// scheduled onto that cluster.
+func (m *ClusterTaintManager) clusterTaintToBindingsMapFunc(ctx context.Context, obj client.Object) []reconcile.Request {
+	cluster, ok := obj.(*clusterv1alpha1.Cluster)
+	if !ok {
+		return nil
+	}
+
+	bindingList := &workv1alpha2.ClusterResourceBindingList{}
+	if err := m.Client.List(ctx, bindingList); err != nil {
+		klog.Errorf("Failed to list ClusterResourceBindings for cluster %s taint change: %v", cluster.Name, err)
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for i := range bindingList.Items {
+		binding := &bindingList.Items[i]
+		for _, targetCluster := range binding.Spec.Clusters {
+			if targetCluster.Name == cluster.Name {
+				requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(binding)})
+				break
+			}
+		}
+	}
+	return requests
+}
+
+// Reconcile mirrors TaintManager.Reconcile but operates on a ClusterResourceBinding.
+func (m *ClusterTaintManager) Reconcile(ctx context.Context, req controllerruntime.Request) (controllerruntime.Result, error) {
+	klog.V(4).Infof("Reconciling NoExecute taints for ClusterResourceBinding %s", req.Name)
+
+	binding := &workv1alpha2.ClusterResourceBinding{}
+	if err := m.Client.Get(ctx, req.NamespacedName, binding); err != nil {
+		if apierrors.IsNotFound(err) {
+			return controllerruntime.Result{}, nil
+		}
+		return controllerruntime.Result{}, err
+	}
+
+	if binding.Spec.Placement == nil {
+		return controllerruntime.Result{}, nil
+	}
+
+	resolvedTasks, needsUpdate := resolveCompletedEvictions(binding.Spec.GracefulEvictionTasks, binding.Spec.Clusters)
+	binding.Spec.GracefulEvictionTasks = resolvedTasks
+
+	delegate := &TaintManager{Client: m.Client, EventRecorder: m.EventRecorder}
+	requeueAfter, evicted, err := delegate.evictBindingClusters(ctx, resourceBindingView(binding), binding.Spec.Placement.ClusterTolerations, binding.Spec.Clusters)
+	if err != nil {
+		return controllerruntime.Result{}, err
+	}
+
+	if len(evicted) != 0 {
+		needsUpdate = true
+		for _, name := range evicted {
+			binding.Spec.GracefulEvictionTasks = append(binding.Spec.GracefulEvictionTasks, workv1alpha2.GracefulEvictionTask{
+				FromCluster: name,
+				Producer:    EvictionProducerTaintManager,
+				Reason:      workv1alpha2.EvictionReasonTaintUntolerated,
+			})
+			m.EventRecorder.Eventf(binding, corev1.EventTypeNormal, TaintManagerEviction,
+				"Evicting cluster %s from ClusterResourceBinding %s: NoExecute taint is no longer tolerated", name, req.Name)
+		}
+	}
+
+	if needsUpdate {
+		if err := m.Client.Update(ctx, binding); err != nil {
+			return controllerruntime.Result{}, err
+		}
+	}
+
+	if requeueAfter > 0 {
+		return controllerruntime.Result{RequeueAfter: requeueAfter}, nil
+	}
+	return controllerruntime.Result{}, nil
+}
+
+// resourceBindingView adapts a ClusterResourceBinding's graceful eviction tasks to the shape
+// evictBindingClusters needs for its already-evicting check, without duplicating that logic.
+func resourceBindingView(binding *workv1alpha2.ClusterResourceBinding) *workv1alpha2.ResourceBinding {
+	return &workv1alpha2.ResourceBinding{
+		Spec: workv1alpha2.ResourceBindingSpec{
+			GracefulEvictionTasks: binding.Spec.GracefulEvictionTasks,
+		},
+	}
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2022 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/util/helper"
+)
+
+var (
+	notReadyTaintTemplate = &corev1.Taint{
+		Key:    clusterv1alpha1.TaintClusterNotReady,
+		Effect: corev1.TaintEffectNoExecute,
+	}
+
+	unreachableTaintTemplate = &corev1.Taint{
+		Key:    clusterv1alpha1.TaintClusterUnreachable,
+		Effect: corev1.TaintEffectNoExecute,
+	}
+)
+
+// systemManagedTaints returns the subset of the cluster's current taints that this controller
+// owns and is allowed to add or remove; user-declared taints (e.g. a maintenance taint) are
+// left out so they're never fed into the diff against the desired system taint set.
+func systemManagedTaints(cluster *clusterv1alpha1.Cluster) []corev1.Taint {
+	var taints []corev1.Taint
+	for _, taint := range cluster.Spec.Taints {
+		if helper.IsSystemManagedTaint(&taint) {
+			taints = append(taints, taint)
+		}
+	}
+	return taints
+}
+
+// desiredSystemTaints computes the taints that the Ready/Reachable conditions imply the cluster
+// should currently carry, so it can be diffed against what's actually on the cluster. It only
+// ever returns taints from the system-managed set above.
+func desiredSystemTaints(readyCondition *metav1.Condition) []corev1.Taint {
+	if readyCondition == nil {
+		return nil
+	}
+
+	now := metav1.Now()
+	switch readyCondition.Status {
+	case metav1.ConditionFalse:
+		taint := notReadyTaintTemplate.DeepCopy()
+		taint.TimeAdded = &now
+		return []corev1.Taint{*taint}
+	case metav1.ConditionUnknown:
+		taint := unreachableTaintTemplate.DeepCopy()
+		taint.TimeAdded = &now
+		return []corev1.Taint{*taint}
+	default:
+		return nil
+	}
+}
+
+// updateClusterTaints reconciles the cluster's system-managed taints against its Ready condition.
+//
+// Rather than being handed an explicit add/remove pair, it derives the desired taint set from
+// the condition and diffs it against the taints the controller currently owns via
+// helper.TaintSetDiff. This makes the reconciliation idempotent across repeated calls and, unlike
+// blindly overwriting cluster.Spec.Taints, never touches taints this controller doesn't own -
+// e.g. a user-declared maintenance taint added directly on the cluster spec survives untouched.
+func updateClusterTaints(readyCondition *metav1.Condition, cluster *clusterv1alpha1.Cluster) []corev1.Taint {
+	wanted := desiredSystemTaints(readyCondition)
+	current := systemManagedTaints(cluster)
+
+	taintsToAdd, taintsToRemove := helper.TaintSetDiff(wanted, current)
+	if len(taintsToAdd) == 0 && len(taintsToRemove) == 0 {
+		return cluster.Spec.Taints
+	}
+
+	return helper.SetCurrentClusterTaints(taintsToAdd, taintsToRemove, cluster)
+}
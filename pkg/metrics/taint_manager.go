@@ -0,0 +1,92 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+)
+
+var pendingTaintEvictionsDesc = prometheus.NewDesc(
+	"karmada_taint_manager_pending_evictions",
+	"Number of cluster evictions currently scheduled by the NoExecute/NoScheduleNoAdmit taint manager and not yet resolved.",
+	nil, nil,
+)
+
+// pendingTaintEvictionCollector derives the pending-eviction count on every scrape by listing
+// ResourceBindings and ClusterResourceBindings and counting outstanding GracefulEvictionTasks
+// produced by the taint manager, instead of tracking it with imperative Inc/Dec calls. Those
+// tasks live in etcd, so counting them directly survives a controller-manager restart; a
+// stateful counter would lose its in-memory tally on restart and could drift negative as
+// pre-restart increments and post-restart decrements got out of sync.
+type pendingTaintEvictionCollector struct {
+	reader   client.Reader
+	producer string
+}
+
+// NewPendingTaintEvictionCollector returns a prometheus.Collector that reports the number of
+// GracefulEvictionTasks with the given producer that are still outstanding across all
+// ResourceBindings and ClusterResourceBindings.
+func NewPendingTaintEvictionCollector(reader client.Reader, producer string) prometheus.Collector {
+	return &pendingTaintEvictionCollector{reader: reader, producer: producer}
+}
+
+// Describe implements prometheus.Collector.
+func (c *pendingTaintEvictionCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- pendingTaintEvictionsDesc
+}
+
+// Collect implements prometheus.Collector.
+func (c *pendingTaintEvictionCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx := context.Background()
+	count := 0
+
+	bindingList := &workv1alpha2.ResourceBindingList{}
+	if err := c.reader.List(ctx, bindingList); err != nil {
+		klog.Errorf("Failed to list ResourceBindings while collecting pending taint eviction metric: %v", err)
+	} else {
+		for i := range bindingList.Items {
+			count += c.countPending(bindingList.Items[i].Spec.GracefulEvictionTasks)
+		}
+	}
+
+	clusterBindingList := &workv1alpha2.ClusterResourceBindingList{}
+	if err := c.reader.List(ctx, clusterBindingList); err != nil {
+		klog.Errorf("Failed to list ClusterResourceBindings while collecting pending taint eviction metric: %v", err)
+	} else {
+		for i := range clusterBindingList.Items {
+			count += c.countPending(clusterBindingList.Items[i].Spec.GracefulEvictionTasks)
+		}
+	}
+
+	ch <- prometheus.MustNewConstMetric(pendingTaintEvictionsDesc, prometheus.GaugeValue, float64(count))
+}
+
+func (c *pendingTaintEvictionCollector) countPending(tasks []workv1alpha2.GracefulEvictionTask) int {
+	count := 0
+	for _, task := range tasks {
+		if task.Producer == c.producer {
+			count++
+		}
+	}
+	return count
+}
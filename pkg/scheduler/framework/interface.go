@@ -0,0 +1,132 @@
+/*
+Copyright 2022 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+)
+
+// Code is the status code/type of a filter plugin's result.
+type Code int
+
+const (
+	// Success means the plugin ran and found the cluster suitable.
+	Success Code = iota
+	// Unschedulable means the plugin ran and found the cluster unsuitable.
+	Unschedulable
+	// Error means the plugin failed to run and it's not clear if the cluster is suitable.
+	Error
+)
+
+// Result carries the outcome of a plugin's Filter call.
+type Result struct {
+	code    Code
+	reasons []string
+}
+
+// NewResult creates a Result with the given code and optional reasons.
+func NewResult(code Code, reasons ...string) *Result {
+	return &Result{code: code, reasons: reasons}
+}
+
+// Code returns the status code.
+func (r *Result) Code() Code {
+	if r == nil {
+		return Success
+	}
+	return r.code
+}
+
+// IsSuccess returns true if the result's code is Success.
+func (r *Result) IsSuccess() bool {
+	return r.Code() == Success
+}
+
+// Reasons returns the reasons recorded on the result.
+func (r *Result) Reasons() []string {
+	if r == nil {
+		return nil
+	}
+	return r.reasons
+}
+
+// Plugin is the parent type for all scheduling framework plugins.
+type Plugin interface {
+	Name() string
+}
+
+// FilterPlugin decides whether a cluster is suitable for a binding's placement.
+type FilterPlugin interface {
+	Plugin
+	Filter(ctx context.Context, placement *policyv1alpha1.Placement, requirement *policyv1alpha1.ReplicaRequirements, cluster *clusterv1alpha1.Cluster) *Result
+}
+
+// ActionType is the kind of change that occurred to an object being watched by the scheduler.
+type ActionType int64
+
+// The set of action types the scheduling queue understands. Bitwise, so they can be combined.
+const (
+	Add ActionType = 1 << iota
+	Update
+	Delete
+
+	All = Add | Update | Delete
+)
+
+// ClusterEvent identifies the kind of object (by GroupVersionKind) and the ActionType(s) a plugin
+// wants to be notified about.
+type ClusterEvent struct {
+	Resource   schema.GroupVersionKind
+	ActionType ActionType
+	Label      string
+}
+
+// QueueingHint indicates whether a specific incoming event may make a previously-unschedulable
+// binding schedulable again.
+type QueueingHint int
+
+const (
+	// QueueSkip means the event doesn't make the binding worth re-evaluating.
+	QueueSkip QueueingHint = iota
+	// Queue means the event may make the binding schedulable and it should be re-queued.
+	Queue
+)
+
+// QueueingHintFn is invoked with the object's state before (oldObj) and after (newObj) the event,
+// plus the binding under consideration, and returns whether that binding should be re-queued.
+type QueueingHintFn func(binding *workv1alpha2.ResourceBinding, oldObj, newObj interface{}) (QueueingHint, error)
+
+// ClusterEventWithHint pairs a ClusterEvent with the QueueingHintFn that decides, per binding,
+// whether the event is relevant.
+type ClusterEventWithHint struct {
+	Event          ClusterEvent
+	QueueingHintFn QueueingHintFn
+}
+
+// EnqueueExtensions is implemented by plugins that want fine-grained control over which events
+// wake up bindings they previously marked unschedulable, instead of the queue re-queueing on
+// every event of a watched type.
+type EnqueueExtensions interface {
+	Plugin
+	EventsToRegister() []ClusterEventWithHint
+}
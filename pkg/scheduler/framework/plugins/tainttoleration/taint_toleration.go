@@ -0,0 +1,133 @@
+/*
+Copyright 2022 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tainttoleration
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/scheduler/framework"
+	"github.com/karmada-io/karmada/pkg/util/helper"
+)
+
+const (
+	// Name is the name of the plugin used in the plugin registry and configurations.
+	Name = "TaintToleration"
+)
+
+// TaintToleration is a plugin that filters out clusters whose NoSchedule or NoScheduleNoAdmit
+// taints aren't tolerated. NoExecute taints are not checked by this plugin; the taint manager
+// evicts bindings that don't tolerate them.
+type TaintToleration struct{}
+
+var (
+	_ framework.FilterPlugin      = &TaintToleration{}
+	_ framework.EnqueueExtensions = &TaintToleration{}
+)
+
+var clusterGVK = clusterv1alpha1.SchemeGroupVersion.WithKind("Cluster")
+
+// New initializes a new plugin and returns it.
+func New() (framework.Plugin, error) {
+	return &TaintToleration{}, nil
+}
+
+// Name returns the plugin name.
+func (p *TaintToleration) Name() string {
+	return Name
+}
+
+// Filter checks if the given cluster's NoSchedule and NoScheduleNoAdmit taints are tolerated by
+// the placement. Other effects are ignored here: PreferNoSchedule, and NoExecute, which is
+// handled separately by the taint manager's graceful eviction.
+func (p *TaintToleration) Filter(_ context.Context, placement *policyv1alpha1.Placement, _ *policyv1alpha1.ReplicaRequirements, cluster *clusterv1alpha1.Cluster) *framework.Result {
+	filterPredicate := func(t *corev1.Taint) bool {
+		return t.Effect == corev1.TaintEffectNoSchedule || t.Effect == clusterv1alpha1.TaintEffectNoScheduleNoAdmit
+	}
+
+	var tolerations []corev1.Toleration
+	if placement != nil {
+		tolerations = placement.ClusterTolerations
+	}
+
+	if !helper.TolerationsTolerateTaintsWithFilter(tolerations, cluster.Spec.Taints, filterPredicate) {
+		return framework.NewResult(framework.Unschedulable, fmt.Sprintf("cluster(%s) had untolerated taint", cluster.Name))
+	}
+
+	return framework.NewResult(framework.Success)
+}
+
+// EventsToRegister declares which Cluster events might turn a binding this plugin previously
+// rejected into a schedulable one again, and how to tell whether that's actually the case for a
+// given binding - so the scheduling queue only wakes bindings the taint change is relevant to,
+// instead of every binding on every cluster update.
+func (p *TaintToleration) EventsToRegister() []framework.ClusterEventWithHint {
+	return []framework.ClusterEventWithHint{
+		{
+			Event:          framework.ClusterEvent{Resource: clusterGVK, ActionType: framework.Add | framework.Update},
+			QueueingHintFn: p.isSchedulableAfterClusterChange,
+		},
+	}
+}
+
+// isSchedulableAfterClusterChange returns Queue only if the taint change between oldObj and
+// newObj plausibly makes the cluster newly schedulable for binding: a taint it didn't tolerate
+// was removed. A taint being added can only make the cluster less schedulable, never more, so
+// this hint - which exists to wake a binding parked in the unschedulable queue - has nothing to
+// do for that case; an added untolerated taint is instead handled by the taint manager, which
+// evicts the binding from clusters it's already scheduled onto.
+func (p *TaintToleration) isSchedulableAfterClusterChange(binding *workv1alpha2.ResourceBinding, oldObj, newObj interface{}) (framework.QueueingHint, error) {
+	newCluster, ok := newObj.(*clusterv1alpha1.Cluster)
+	if !ok {
+		return framework.Queue, fmt.Errorf("unexpected object type %T for Cluster event", newObj)
+	}
+
+	var oldTaints []corev1.Taint
+	if oldObj != nil {
+		oldCluster, ok := oldObj.(*clusterv1alpha1.Cluster)
+		if !ok {
+			return framework.Queue, fmt.Errorf("unexpected object type %T for Cluster event", oldObj)
+		}
+		oldTaints = oldCluster.Spec.Taints
+	}
+
+	_, taintsRemoved := helper.TaintSetDiff(newCluster.Spec.Taints, oldTaints)
+	if len(taintsRemoved) == 0 {
+		return framework.QueueSkip, nil
+	}
+
+	var tolerations []corev1.Toleration
+	if binding.Spec.Placement != nil {
+		tolerations = binding.Spec.Placement.ClusterTolerations
+	}
+
+	for _, taint := range taintsRemoved {
+		if tolerated, _ := helper.GetMatchingTolerations([]corev1.Taint{*taint}, tolerations); !tolerated {
+			// The binding didn't tolerate this taint before, so removing it might newly
+			// make the cluster schedulable.
+			return framework.Queue, nil
+		}
+	}
+
+	return framework.QueueSkip, nil
+}
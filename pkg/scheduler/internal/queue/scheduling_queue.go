@@ -0,0 +1,133 @@
+/*
+Copyright 2024 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queue
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	workv1alpha2 "github.com/karmada-io/karmada/pkg/apis/work/v1alpha2"
+	"github.com/karmada-io/karmada/pkg/scheduler/framework"
+)
+
+// clusterEventHintKey identifies a registered ClusterEventWithHint by the GroupVersionKind of the
+// object it watches and the action(s) it cares about, so lookups don't have to scan every plugin
+// on every queue move.
+type clusterEventHintKey struct {
+	gvk        schema.GroupVersionKind
+	actionType framework.ActionType
+}
+
+// SchedulingQueue holds unschedulable bindings and decides, on every watched-object event, which
+// of them are worth moving back to the active queue.
+type SchedulingQueue struct {
+	activeQueue           workqueue.RateLimitingInterface
+	unschedulableBindings map[string]*workv1alpha2.ResourceBinding
+
+	hints map[clusterEventHintKey][]framework.QueueingHintFn
+}
+
+// NewSchedulingQueue creates an empty SchedulingQueue.
+func NewSchedulingQueue() *SchedulingQueue {
+	return &SchedulingQueue{
+		activeQueue:           workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
+		unschedulableBindings: map[string]*workv1alpha2.ResourceBinding{},
+		hints:                 map[clusterEventHintKey][]framework.QueueingHintFn{},
+	}
+}
+
+// RegisterClusterEventHint registers a plugin's QueueingHintFn for the given ClusterEvent(s),
+// typically gathered from every plugin implementing framework.EnqueueExtensions at setup time.
+func (q *SchedulingQueue) RegisterClusterEventHint(events []framework.ClusterEventWithHint) {
+	for _, e := range events {
+		key := clusterEventHintKey{gvk: e.Event.Resource, actionType: e.Event.ActionType}
+		q.hints[key] = append(q.hints[key], e.QueueingHintFn)
+	}
+}
+
+// AddUnschedulable parks a binding that couldn't be scheduled this round. It stays parked until a
+// later event moves it through MoveAllToActiveOrBackoffQueue; no timer wakes it on its own.
+func (q *SchedulingQueue) AddUnschedulable(binding *workv1alpha2.ResourceBinding) {
+	q.unschedulableBindings[bindingKey(binding)] = binding
+}
+
+// MoveAllToActiveOrBackoffQueue is called whenever a watched object of the given GVK fires an
+// event. Instead of unconditionally waking every parked binding, it consults the QueueingHintFns
+// registered for that GVK+ActionType and only re-queues the bindings that at least one hint
+// function says are worth re-evaluating - everything else stays parked.
+func (q *SchedulingQueue) MoveAllToActiveOrBackoffQueue(gvk schema.GroupVersionKind, actionType framework.ActionType, oldObj, newObj interface{}) {
+	hints := q.hintsFor(gvk, actionType)
+	if len(hints) == 0 {
+		// No plugin registered a hint for this event, fall back to waking everyone so we
+		// never silently miss a relevant change.
+		for key, binding := range q.unschedulableBindings {
+			q.moveToActive(key, binding)
+		}
+		return
+	}
+
+	for key, binding := range q.unschedulableBindings {
+		if q.shouldMove(binding, hints, oldObj, newObj) {
+			q.moveToActive(key, binding)
+		}
+	}
+}
+
+func (q *SchedulingQueue) hintsFor(gvk schema.GroupVersionKind, actionType framework.ActionType) []framework.QueueingHintFn {
+	var hints []framework.QueueingHintFn
+	for key, fns := range q.hints {
+		if key.gvk != gvk {
+			continue
+		}
+		if key.actionType&actionType == 0 {
+			continue
+		}
+		hints = append(hints, fns...)
+	}
+	return hints
+}
+
+func (q *SchedulingQueue) shouldMove(binding *workv1alpha2.ResourceBinding, hints []framework.QueueingHintFn, oldObj, newObj interface{}) bool {
+	for _, hint := range hints {
+		queueingHint, err := hint(binding, oldObj, newObj)
+		if err != nil {
+			klog.Errorf("Failed to run queueing hint for binding %s: %v", bindingKey(binding), err)
+			// Fail open: an erroring hint shouldn't strand a binding in the parked set forever.
+			return true
+		}
+		if queueingHint == framework.Queue {
+			return true
+		}
+	}
+	return false
+}
+
+func (q *SchedulingQueue) moveToActive(key string, binding *workv1alpha2.ResourceBinding) {
+	delete(q.unschedulableBindings, key)
+	q.activeQueue.Add(key)
+	_ = binding
+}
+
+func bindingKey(binding *workv1alpha2.ResourceBinding) string {
+	if binding.Namespace == "" {
+		return binding.Name
+	}
+	return fmt.Sprintf("%s/%s", binding.Namespace, binding.Name)
+}
@@ -0,0 +1,310 @@
+/*
+Copyright 2022 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helper
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	policyv1alpha1 "github.com/karmada-io/karmada/pkg/apis/policy/v1alpha1"
+)
+
+// systemManagedTaintKeys is the set of taint keys Karmada itself owns and may add or remove
+// while reconciling a Cluster's Ready/Reachable conditions. Any other taint key found on
+// Cluster.Spec.Taints is considered user-declared (e.g. a maintenance or gpu-only taint) and
+// must never be touched by condition-driven reconciliation.
+var systemManagedTaintKeys = map[string]struct{}{
+	clusterv1alpha1.TaintClusterNotReady:    {},
+	clusterv1alpha1.TaintClusterUnreachable: {},
+}
+
+// IsSystemManagedTaint reports whether the given taint is one of Karmada's own condition-driven
+// taints, as opposed to a user-declared one.
+func IsSystemManagedTaint(taint *corev1.Taint) bool {
+	_, ok := systemManagedTaintKeys[taint.Key]
+	return ok
+}
+
+// CheckTaintValidation validates a taint's key, value and effect, returning a list of
+// human-readable validation errors. An empty slice means the taint is valid.
+func CheckTaintValidation(taint *corev1.Taint) []string {
+	var allErrs []string
+
+	for _, msg := range validation.IsQualifiedName(taint.Key) {
+		allErrs = append(allErrs, fmt.Sprintf("invalid taint key %q: %s", taint.Key, msg))
+	}
+
+	if taint.Value != "" {
+		for _, msg := range validation.IsValidLabelValue(taint.Value) {
+			allErrs = append(allErrs, fmt.Sprintf("invalid taint value %q: %s", taint.Value, msg))
+		}
+	}
+
+	switch taint.Effect {
+	case corev1.TaintEffectNoSchedule, corev1.TaintEffectPreferNoSchedule, corev1.TaintEffectNoExecute,
+		clusterv1alpha1.TaintEffectNoScheduleNoAdmit:
+	default:
+		allErrs = append(allErrs, fmt.Sprintf("invalid taint effect: %q", taint.Effect))
+	}
+
+	return allErrs
+}
+
+// TaintExists checks if the given taint exists in list of taints.
+func TaintExists(taints []corev1.Taint, taintToFind *corev1.Taint) bool {
+	for _, taint := range taints {
+		if taint.MatchTaint(taintToFind) {
+			return true
+		}
+	}
+	return false
+}
+
+// TolerationExists checks if the given toleration exists in list of tolerations.
+func TolerationExists(tolerations []corev1.Toleration, tolerationToFind *corev1.Toleration) bool {
+	for _, toleration := range tolerations {
+		if toleration.MatchToleration(tolerationToFind) {
+			return true
+		}
+	}
+	return false
+}
+
+// AddTolerations adds tolerations to the given placement, skipping the ones that already exist.
+func AddTolerations(placement *policyv1alpha1.Placement, tolerations ...*corev1.Toleration) {
+	for _, toleration := range tolerations {
+		if TolerationExists(placement.ClusterTolerations, toleration) {
+			continue
+		}
+		placement.ClusterTolerations = append(placement.ClusterTolerations, *toleration)
+	}
+}
+
+// TaintSetFilter returns a slice of taints satisfied the given predicate.
+func TaintSetFilter(taints []corev1.Taint, fn func(*corev1.Taint) bool) []*corev1.Taint {
+	var res []*corev1.Taint
+
+	for i := range taints {
+		if fn(&taints[i]) {
+			res = append(res, &taints[i])
+		}
+	}
+
+	return res
+}
+
+// TaintSetDiff finds the difference between two taint slices and
+// returns all new and removed elements of the new taint slice relative to the old one.
+func TaintSetDiff(taintsNew, taintsOld []corev1.Taint) (taintsToAdd, taintsToRemove []*corev1.Taint) {
+	taintsToAdd = TaintSetFilter(taintsNew, func(t *corev1.Taint) bool {
+		return !TaintExists(taintsOld, t)
+	})
+	taintsToRemove = TaintSetFilter(taintsOld, func(t *corev1.Taint) bool {
+		return !TaintExists(taintsNew, t)
+	})
+
+	return
+}
+
+// SetCurrentClusterTaints applies the given taintsToAdd/taintsToRemove pair to the cluster's
+// current taints and sets the resulting taint set back onto the cluster. It is idempotent and
+// leaves taints that are neither being added nor removed (e.g. user-declared taints) untouched.
+func SetCurrentClusterTaints(taintsToAdd, taintsToRemove []*corev1.Taint, cluster *clusterv1alpha1.Cluster) []corev1.Taint {
+	newTaints := make([]corev1.Taint, 0, len(cluster.Spec.Taints)+len(taintsToAdd))
+	for i := range cluster.Spec.Taints {
+		taint := cluster.Spec.Taints[i]
+		removed := false
+		for _, taintToRemove := range taintsToRemove {
+			if taint.MatchTaint(taintToRemove) {
+				removed = true
+				break
+			}
+		}
+		if !removed {
+			newTaints = append(newTaints, taint)
+		}
+	}
+
+	for _, taint := range taintsToAdd {
+		if !TaintExists(newTaints, taint) {
+			newTaints = append(newTaints, *taint)
+		}
+	}
+
+	cluster.Spec.Taints = newTaints
+	return cluster.Spec.Taints
+}
+
+// HasNoExecuteTaints returns true if the given taints have at least one NoExecute taint.
+func HasNoExecuteTaints(taints []corev1.Taint) bool {
+	for _, taint := range taints {
+		if taint.Effect == corev1.TaintEffectNoExecute {
+			return true
+		}
+	}
+	return false
+}
+
+// GetNoExecuteTaints returns the subset of the given taints whose effect is NoExecute.
+func GetNoExecuteTaints(taints []corev1.Taint) []corev1.Taint {
+	var result []corev1.Taint
+	for _, taint := range taints {
+		if taint.Effect == corev1.TaintEffectNoExecute {
+			result = append(result, taint)
+		}
+	}
+	return result
+}
+
+// TolerationsTolerateTaintsWithFilter checks if the given tolerations tolerate all the taints
+// that pass filterFn, ignoring the ones that don't. A nil filterFn matches every taint.
+func TolerationsTolerateTaintsWithFilter(tolerations []corev1.Toleration, taints []corev1.Taint, filterFn func(*corev1.Taint) bool) bool {
+	if len(taints) == 0 {
+		return true
+	}
+
+	for i := range taints {
+		if filterFn != nil && !filterFn(&taints[i]) {
+			continue
+		}
+		if tolerated, _ := GetMatchingTolerations([]corev1.Taint{taints[i]}, tolerations); !tolerated {
+			return false
+		}
+	}
+
+	return true
+}
+
+// HasEvictingTaints returns true if any of the given taints requires bindings that don't
+// tolerate it to be actively moved off the cluster - either through NoExecute eviction once any
+// tolerationSeconds have run out, or through NoScheduleNoAdmit forcing re-evaluation on the next
+// scheduling cycle.
+func HasEvictingTaints(taints []corev1.Taint) bool {
+	for _, taint := range taints {
+		if taint.Effect == corev1.TaintEffectNoExecute || taint.Effect == clusterv1alpha1.TaintEffectNoScheduleNoAdmit {
+			return true
+		}
+	}
+	return false
+}
+
+// GetMatchingTolerations returns true and the list of tolerations matching all the given taints,
+// or false and an empty list if any of the taints is not tolerated.
+func GetMatchingTolerations(taints []corev1.Taint, tolerations []corev1.Toleration) (bool, []corev1.Toleration) {
+	if len(taints) == 0 {
+		return true, []corev1.Toleration{}
+	}
+	if len(tolerations) == 0 {
+		return false, []corev1.Toleration{}
+	}
+
+	result := []corev1.Toleration{}
+	for i := range taints {
+		tolerated := false
+		for j := range tolerations {
+			if tolerations[j].ToleratesTaint(&taints[i]) {
+				result = append(result, tolerations[j])
+				tolerated = true
+				break
+			}
+		}
+		if !tolerated {
+			return false, []corev1.Toleration{}
+		}
+	}
+	return true, result
+}
+
+// GetMinTolerationTimeWithCurrentTime returns the minimum remaining duration, as of now, until
+// the given NoExecute taints' tolerations (previously matched via GetMatchingTolerations) expire.
+//
+// It returns:
+//   - -1 if there are no NoExecute taints to evict for, or if every matching toleration tolerates
+//     its taint forever (TolerationSeconds is nil) or has an unknown TimeAdded - a taint that
+//     tolerates forever just doesn't contribute a deadline, it doesn't short-circuit the scan for
+//     the ones that do;
+//   - 0 if a taint isn't tolerated at all, or if some toleration window has already elapsed;
+//   - otherwise the smallest positive remaining duration across all the taints that do have one.
+func GetMinTolerationTimeWithCurrentTime(noExecuteTaints []corev1.Taint, usedTolerations []corev1.Toleration, now time.Time) time.Duration {
+	if len(noExecuteTaints) == 0 {
+		return -1
+	}
+
+	minTolerationTime := time.Duration(-1)
+	found := false
+	for i := range noExecuteTaints {
+		taint := &noExecuteTaints[i]
+
+		var matchedToleration *corev1.Toleration
+		for j := range usedTolerations {
+			if usedTolerations[j].ToleratesTaint(taint) {
+				matchedToleration = &usedTolerations[j]
+				break
+			}
+		}
+		if matchedToleration == nil {
+			return 0
+		}
+		if matchedToleration.TolerationSeconds == nil {
+			// Tolerated forever - this taint has no deadline of its own, but others might.
+			continue
+		}
+		if taint.TimeAdded == nil {
+			continue
+		}
+
+		elapsed := now.Sub(taint.TimeAdded.Time)
+		remaining := time.Duration(*matchedToleration.TolerationSeconds)*time.Second - elapsed
+		if remaining < 0 {
+			remaining = 0
+		}
+
+		if !found || remaining < minTolerationTime {
+			minTolerationTime = remaining
+			found = true
+		}
+	}
+
+	if !found {
+		return -1
+	}
+	return minTolerationTime
+}
+
+// GenerateTaintsMessage generates a human-readable message describing the given taints, suitable
+// for use as a cluster event/condition message.
+func GenerateTaintsMessage(taints []corev1.Taint) string {
+	if len(taints) == 0 {
+		return "cluster now does not have taints"
+	}
+
+	taintStrs := make([]string, 0, len(taints))
+	for _, taint := range taints {
+		if taint.Value != "" {
+			taintStrs = append(taintStrs, fmt.Sprintf("{Key:%s,Value:%s,Effect:%s}", taint.Key, taint.Value, taint.Effect))
+		} else {
+			taintStrs = append(taintStrs, fmt.Sprintf("{Key:%s,Effect:%s}", taint.Key, taint.Effect))
+		}
+	}
+
+	return fmt.Sprintf("cluster now has taints([%s])", strings.Join(taintStrs, ","))
+}
@@ -517,6 +517,38 @@ func TestGetMinTolerationTimeWithCurrentTime(t *testing.T) {
 			},
 			wantResult: 60,
 		},
+		{
+			name: "one taint tolerated forever, another has a finite deadline",
+			noExecuteTaints: []corev1.Taint{
+				{
+					Key:       "key1",
+					Value:     "value1",
+					Effect:    corev1.TaintEffectNoExecute,
+					TimeAdded: &metav1.Time{Time: fixedTime},
+				},
+				{
+					Key:       "key2",
+					Value:     "value2",
+					Effect:    corev1.TaintEffectNoExecute,
+					TimeAdded: &metav1.Time{Time: fixedTime},
+				},
+			},
+			usedTolerantion: []corev1.Toleration{
+				{
+					Key:               "key1",
+					Operator:          corev1.TolerationOpExists,
+					Effect:            corev1.TaintEffectNoExecute,
+					TolerationSeconds: nil, // tolerated forever - must not short-circuit the scan
+				},
+				{
+					Key:               "key2",
+					Operator:          corev1.TolerationOpExists,
+					Effect:            corev1.TaintEffectNoExecute,
+					TolerationSeconds: &[]int64{60}[0],
+				},
+			},
+			wantResult: 60,
+		},
 		{
 			name: "trigger time is up",
 			noExecuteTaints: []corev1.Taint{
@@ -663,6 +695,33 @@ func TestGetMatchingTolerations(t *testing.T) {
 			wantActual:            false,
 			wantActualTolerations: []corev1.Toleration{},
 		},
+		{
+			name: "tolerated NoScheduleNoAdmit taint",
+			taints: []corev1.Taint{
+				{
+					Key:    "maintenance",
+					Value:  "true",
+					Effect: clusterv1alpha1.TaintEffectNoScheduleNoAdmit,
+				},
+			},
+			tolerations: []corev1.Toleration{
+				{
+					Key:      "maintenance",
+					Value:    "true",
+					Operator: corev1.TolerationOpEqual,
+					Effect:   clusterv1alpha1.TaintEffectNoScheduleNoAdmit,
+				},
+			},
+			wantActual: true,
+			wantActualTolerations: []corev1.Toleration{
+				{
+					Key:      "maintenance",
+					Value:    "true",
+					Operator: corev1.TolerationOpEqual,
+					Effect:   clusterv1alpha1.TaintEffectNoScheduleNoAdmit,
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -724,3 +783,95 @@ func TestGenerateTaintsMessage(t *testing.T) {
 		})
 	}
 }
+
+func TestTolerationsTolerateTaintsWithFilter(t *testing.T) {
+	onlyNoSchedule := func(t *corev1.Taint) bool {
+		return t.Effect == corev1.TaintEffectNoSchedule || t.Effect == clusterv1alpha1.TaintEffectNoScheduleNoAdmit
+	}
+
+	tests := []struct {
+		name        string
+		tolerations []corev1.Toleration
+		taints      []corev1.Taint
+		filterFn    func(*corev1.Taint) bool
+		want        bool
+	}{
+		{
+			name:   "no taints",
+			taints: []corev1.Taint{},
+			want:   true,
+		},
+		{
+			name: "filter skips the untolerated taint",
+			taints: []corev1.Taint{
+				{Key: "key1", Effect: corev1.TaintEffectNoExecute},
+			},
+			filterFn: onlyNoSchedule,
+			want:     true,
+		},
+		{
+			name: "filtered taint is tolerated",
+			taints: []corev1.Taint{
+				{Key: "key1", Effect: corev1.TaintEffectNoSchedule},
+			},
+			tolerations: []corev1.Toleration{
+				{Key: "key1", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule},
+			},
+			filterFn: onlyNoSchedule,
+			want:     true,
+		},
+		{
+			name: "filtered taint is not tolerated",
+			taints: []corev1.Taint{
+				{Key: "key1", Effect: clusterv1alpha1.TaintEffectNoScheduleNoAdmit},
+			},
+			filterFn: onlyNoSchedule,
+			want:     false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TolerationsTolerateTaintsWithFilter(tt.tolerations, tt.taints, tt.filterFn); got != tt.want {
+				t.Errorf("TolerationsTolerateTaintsWithFilter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHasEvictingTaints(t *testing.T) {
+	tests := []struct {
+		name   string
+		taints []corev1.Taint
+		want   bool
+	}{
+		{
+			name:   "no taints",
+			taints: []corev1.Taint{},
+			want:   false,
+		},
+		{
+			name:   "NoExecute taint",
+			taints: []corev1.Taint{{Key: "key1", Effect: corev1.TaintEffectNoExecute}},
+			want:   true,
+		},
+		{
+			name:   "NoScheduleNoAdmit taint",
+			taints: []corev1.Taint{{Key: "key1", Effect: clusterv1alpha1.TaintEffectNoScheduleNoAdmit}},
+			want:   true,
+		},
+		{
+			name:   "NoSchedule taint only",
+			taints: []corev1.Taint{{Key: "key1", Effect: corev1.TaintEffectNoSchedule}},
+			want:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HasEvictingTaints(tt.taints); got != tt.want {
+				t.Errorf("HasEvictingTaints() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2022 The Karmada Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	clusterv1alpha1 "github.com/karmada-io/karmada/pkg/apis/cluster/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/util/helper"
+)
+
+// karmadaControllerManagerServiceAccount is the identity the cluster-status controller uses when
+// patching a Cluster's system-managed taints (cluster.karmada.io/not-ready and
+// cluster.karmada.io/unreachable). Only requests from it may add a taint with one of those
+// reserved keys; such a request from anyone else is rejected so a user-declared taint can never
+// collide with, and later be silently stripped by, condition-driven reconciliation. Removing a
+// reserved taint is not checked.
+const karmadaControllerManagerServiceAccount = "system:serviceaccount:karmada-system:karmada-controller-manager"
+
+// ValidatingAdmission validates Cluster objects on create and update, rejecting malformed
+// cluster taints before they reach etcd.
+type ValidatingAdmission struct {
+	decoder admission.Decoder
+}
+
+// Check if our ValidatingAdmission implements necessary interfaces
+var _ admission.Handler = &ValidatingAdmission{}
+
+// Handle implements admission.Handler interface.
+// It rejects the request if the incoming Cluster carries an invalid or duplicate taint.
+func (v *ValidatingAdmission) Handle(_ context.Context, req admission.Request) admission.Response {
+	cluster := &clusterv1alpha1.Cluster{}
+	if err := v.decoder.Decode(req, cluster); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	var oldTaints []corev1.Taint
+	if len(req.OldObject.Raw) != 0 {
+		oldCluster := &clusterv1alpha1.Cluster{}
+		if err := v.decoder.DecodeRaw(req.OldObject, oldCluster); err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		oldTaints = oldCluster.Spec.Taints
+	}
+
+	if errs := validateClusterTaints(cluster.Spec.Taints, oldTaints, req.UserInfo.Username); len(errs) != 0 {
+		return admission.Denied(strings.Join(errs, "; "))
+	}
+
+	return admission.Allowed("")
+}
+
+// validateClusterTaints validates every taint declared on a Cluster and rejects duplicate
+// key+effect pairs, which would make the taint set ambiguous to reconcile.
+//
+// The reserved-key check only looks at what's being added or changed relative to oldTaints
+// (via helper.TaintSetDiff), not at the full taint list: an ordinary update simply echoes back
+// whatever system taint the cluster-status controller already set (e.g. while the cluster is
+// transiently NotReady), and that must keep passing for anyone. Only a non-controller request
+// that newly introduces or alters a reserved key is rejected.
+func validateClusterTaints(taints, oldTaints []corev1.Taint, requestUser string) []string {
+	var allErrs []string
+	seen := make(map[string]struct{}, len(taints))
+
+	for i := range taints {
+		taint := taints[i]
+		allErrs = append(allErrs, helper.CheckTaintValidation(&taint)...)
+
+		key := fmt.Sprintf("%s:%s", taint.Key, taint.Effect)
+		if _, ok := seen[key]; ok {
+			allErrs = append(allErrs, fmt.Sprintf("duplicate taint %q with effect %q", taint.Key, taint.Effect))
+			continue
+		}
+		seen[key] = struct{}{}
+	}
+
+	if requestUser != karmadaControllerManagerServiceAccount {
+		taintsToAdd, _ := helper.TaintSetDiff(taints, oldTaints)
+		for _, taint := range taintsToAdd {
+			if helper.IsSystemManagedTaint(taint) {
+				allErrs = append(allErrs, fmt.Sprintf("taint key %q is reserved for Karmada's cluster-status controller and cannot be set directly", taint.Key))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// InjectDecoder implements admission.DecoderInjector interface.
+func (v *ValidatingAdmission) InjectDecoder(d admission.Decoder) error {
+	v.decoder = d
+	return nil
+}